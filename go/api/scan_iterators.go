@@ -0,0 +1,117 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package api hosts the caller-facing entry points built on top of the options package's
+// cursor iterators.
+//
+// NOTE: this checkout does not contain the GlideClient/GlideClusterClient implementation, so
+// the constructors below take a minimal interface a concrete client satisfies rather than being
+// methods on that client directly (the request asked for client.ZScanIterator(ctx, key, opts));
+// GlideClient and GlideClusterClient already implement *ScanWithOptions per the naming used
+// elsewhere in this client, so no behavior changes are needed on that side, but adding the thin
+// client.ZScanIterator/.HScanIterator/.SScanIterator/.ScanIterator methods that call into these
+// functions is left for a follow-up once that package is available in this tree.
+package api
+
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// ZScanClient is satisfied by any client that can issue ZSCAN, e.g. GlideClient or
+// GlideClusterClient.
+type ZScanClient interface {
+	ZScanWithOptions(ctx context.Context, key string, cursor string, zScanOptions *options.ZScanOptions) (string, []string, error)
+}
+
+// ZScanIterator returns an iterator that pages through the full ZSCAN result set for key,
+// hiding cursor management from the caller. It reissues ZSCAN through client with the cursor
+// returned by the previous call until it wraps back to "0".
+func ZScanIterator(client ZScanClient, key string, zScanOptions *options.ZScanOptions) *options.ZScanIterator {
+	if zScanOptions == nil {
+		zScanOptions = options.NewZScanOptions()
+	}
+	fetch := func(ctx context.Context, cursor string) (string, []string, error) {
+		return client.ZScanWithOptions(ctx, key, cursor, zScanOptions)
+	}
+	return options.NewZScanIterator(fetch, zScanOptions.NoScores)
+}
+
+// HScanClient is satisfied by any client that can issue HSCAN, e.g. GlideClient or
+// GlideClusterClient.
+type HScanClient interface {
+	HScanWithOptions(ctx context.Context, key string, cursor string, hScanOptions *options.HScanOptions) (string, []string, error)
+}
+
+// HScanIterator returns an iterator that pages through the full HSCAN result set for key.
+func HScanIterator(client HScanClient, key string, hScanOptions *options.HScanOptions) *options.HScanIterator {
+	if hScanOptions == nil {
+		hScanOptions = options.NewHScanOptions()
+	}
+	fetch := func(ctx context.Context, cursor string) (string, []string, error) {
+		return client.HScanWithOptions(ctx, key, cursor, hScanOptions)
+	}
+	return options.NewHScanIterator(fetch, hScanOptions.NoValues)
+}
+
+// SScanClient is satisfied by any client that can issue SSCAN, e.g. GlideClient or
+// GlideClusterClient.
+type SScanClient interface {
+	SScanWithOptions(ctx context.Context, key string, cursor string, sScanOptions *options.SScanOptions) (string, []string, error)
+}
+
+// SScanIterator returns an iterator that pages through the full SSCAN result set for key.
+func SScanIterator(client SScanClient, key string, sScanOptions *options.SScanOptions) *options.SScanIterator {
+	if sScanOptions == nil {
+		sScanOptions = options.NewSScanOptions()
+	}
+	fetch := func(ctx context.Context, cursor string) (string, []string, error) {
+		return client.SScanWithOptions(ctx, key, cursor, sScanOptions)
+	}
+	return options.NewSScanIterator(fetch)
+}
+
+// ScanClient is satisfied by a standalone client that can issue SCAN against the single node it
+// is connected to, e.g. GlideClient.
+type ScanClient interface {
+	ScanWithOptions(ctx context.Context, cursor string, scanOptions *options.ScanOptions) (string, []string, error)
+}
+
+// ScanIterator returns an iterator that pages through the full SCAN result set visible to a
+// standalone client's single node.
+func ScanIterator(client ScanClient, scanOptions *options.ScanOptions) *options.ScanIterator {
+	if scanOptions == nil {
+		scanOptions = options.NewScanOptions()
+	}
+	fetch := func(ctx context.Context, cursor string) (string, []string, error) {
+		return client.ScanWithOptions(ctx, cursor, scanOptions)
+	}
+	return options.NewScanIterator(fetch)
+}
+
+// ClusterScanNode pairs a cluster node identifier with the ScanClient connected to it.
+type ClusterScanNode struct {
+	NodeID string
+	Client ScanClient
+}
+
+// ClusterScanIterator returns an iterator that fans SCAN out across every node in nodes. A
+// cluster's keyspace is partitioned across nodes, so no single SCAN call against one node sees
+// all of it; GlideClusterClient is expected to supply one ClusterScanNode per node it knows
+// about.
+func ClusterScanIterator(nodes []ClusterScanNode, scanOptions *options.ScanOptions) *options.ClusterScanIterator {
+	if scanOptions == nil {
+		scanOptions = options.NewScanOptions()
+	}
+	fetchers := make([]options.NodeScanFetcher, 0, len(nodes))
+	for _, node := range nodes {
+		node := node
+		fetchers = append(fetchers, options.NodeScanFetcher{
+			NodeID: node.NodeID,
+			Fetch: func(ctx context.Context, cursor string) (string, []string, error) {
+				return node.Client.ScanWithOptions(ctx, cursor, scanOptions)
+			},
+		})
+	}
+	return options.NewClusterScanIterator(fetchers)
+}
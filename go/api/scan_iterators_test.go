@@ -0,0 +1,104 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+type fakeZScanClient struct {
+	pages map[string]struct {
+		next     string
+		elements []string
+	}
+}
+
+func (f *fakeZScanClient) ZScanWithOptions(
+	_ context.Context,
+	key string,
+	cursor string,
+	_ *options.ZScanOptions,
+) (string, []string, error) {
+	page := f.pages[cursor]
+	return page.next, page.elements, nil
+}
+
+func TestZScanIterator_EndToEnd(t *testing.T) {
+	client := &fakeZScanClient{pages: map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0":  {next: "17", elements: []string{"a", "1"}},
+		"17": {next: "0", elements: []string{"b", "2"}},
+	}}
+
+	it := ZScanIterator(client, "myset", nil)
+	var got []string
+	for {
+		member, _, ok := it.Next(context.Background())
+		if !ok {
+			break
+		}
+		got = append(got, member)
+	}
+	if it.Err() != nil {
+		t.Fatalf("Err() = %v, want nil", it.Err())
+	}
+	if len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Fatalf("got %v, want [a b]", got)
+	}
+}
+
+type fakeScanClient struct {
+	pages map[string]struct {
+		next     string
+		elements []string
+	}
+}
+
+func (f *fakeScanClient) ScanWithOptions(
+	_ context.Context,
+	cursor string,
+	_ *options.ScanOptions,
+) (string, []string, error) {
+	page := f.pages[cursor]
+	return page.next, page.elements, nil
+}
+
+func TestClusterScanIterator_EndToEnd(t *testing.T) {
+	nodeA := &fakeScanClient{pages: map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0": {next: "0", elements: []string{"a-key"}},
+	}}
+	nodeB := &fakeScanClient{pages: map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0": {next: "0", elements: []string{"b-key"}},
+	}}
+
+	it := ClusterScanIterator([]ClusterScanNode{
+		{NodeID: "node-a", Client: nodeA},
+		{NodeID: "node-b", Client: nodeB},
+	}, nil)
+
+	var got []string
+	for {
+		key, ok := it.Next(context.Background())
+		if !ok {
+			break
+		}
+		got = append(got, key)
+	}
+	if it.Err() != nil {
+		t.Fatalf("Err() = %v, want nil", it.Err())
+	}
+	if len(got) != 2 || got[0] != "a-key" || got[1] != "b-key" {
+		t.Fatalf("got %v, want [a-key b-key]", got)
+	}
+}
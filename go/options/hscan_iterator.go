@@ -0,0 +1,84 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"context"
+	"errors"
+)
+
+// errShortHScanPage indicates the server returned a malformed HSCAN page whose element count
+// doesn't match the expected field-only or field/value pair shape.
+var errShortHScanPage = errors.New("hscan: malformed page, element count does not match NoValues setting")
+
+// HScanIterator hides HSCAN cursor management from the caller. It reissues HSCAN with the
+// cursor returned by the previous call, buffering each page, until the cursor wraps back to
+// "0". Create one with NewHScanIterator or NewHScanIteratorFromCursor.
+type HScanIterator struct {
+	pager    cursorPager
+	noValues bool
+
+	lastHasValue bool
+}
+
+// NewHScanIterator creates an HScanIterator that pages through HSCAN results via fetch, starting
+// a fresh scan at cursor "0". noValues must match the NoValues setting of the HScanOptions used
+// to build fetch, since it controls whether buffered elements are decoded as field/value pairs
+// or fields only.
+func NewHScanIterator(fetch ScanFetcher, noValues bool) *HScanIterator {
+	return NewHScanIteratorFromCursor(fetch, noValues, "0")
+}
+
+// NewHScanIteratorFromCursor creates an HScanIterator that resumes a scan from startCursor, e.g.
+// one previously returned by another HScanIterator's Cursor().
+func NewHScanIteratorFromCursor(fetch ScanFetcher, noValues bool, startCursor string) *HScanIterator {
+	return &HScanIterator{pager: newCursorPager(fetch, startCursor), noValues: noValues}
+}
+
+// Next advances the iterator and returns the next field and its value. ok is false once the
+// scan is exhausted, the iterator was closed, or an error occurred; callers should check Err()
+// in that case. When the iterator was created with noValues, value is always "" and HasValue()
+// reports false for the element just returned.
+func (it *HScanIterator) Next(ctx context.Context) (field string, value string, ok bool) {
+	if !it.pager.fill(ctx) {
+		return "", "", false
+	}
+
+	if it.noValues {
+		field = it.pager.buffer[0]
+		it.pager.buffer = it.pager.buffer[1:]
+		it.lastHasValue = false
+		return field, "", true
+	}
+
+	if len(it.pager.buffer) < 2 {
+		it.pager.err = errShortHScanPage
+		return "", "", false
+	}
+	field, value = it.pager.buffer[0], it.pager.buffer[1]
+	it.pager.buffer = it.pager.buffer[2:]
+	it.lastHasValue = true
+	return field, value, true
+}
+
+// HasValue reports whether the element returned by the most recent call to Next carried a
+// value. It is always false when the iterator was created with noValues.
+func (it *HScanIterator) HasValue() bool {
+	return it.lastHasValue
+}
+
+// Err returns the first error encountered while fetching or decoding a page, if any.
+func (it *HScanIterator) Err() error {
+	return it.pager.Err()
+}
+
+// Close stops the iterator early. Further calls to Next return false.
+func (it *HScanIterator) Close() error {
+	return it.pager.Close()
+}
+
+// Cursor returns the raw HSCAN cursor the iterator will resume from on the next fetch. Persist
+// it and pass it to NewHScanIteratorFromCursor to checkpoint and resume a scan across processes.
+func (it *HScanIterator) Cursor() string {
+	return it.pager.Cursor()
+}
@@ -0,0 +1,112 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"context"
+	"testing"
+)
+
+func TestHScanIterator_PagesAcrossCursors(t *testing.T) {
+	fetch := pagedFetcher(map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0":  {next: "17", elements: []string{"f1", "v1"}},
+		"17": {next: "0", elements: []string{"f2", "v2"}},
+	})
+
+	it := NewHScanIterator(fetch, false)
+	var fields []string
+	for {
+		field, value, ok := it.Next(context.Background())
+		if !ok {
+			break
+		}
+		fields = append(fields, field)
+		if !it.HasValue() {
+			t.Errorf("HasValue() = false, want true for field %q", field)
+		}
+		if (field == "f1" && value != "v1") || (field == "f2" && value != "v2") {
+			t.Errorf("field %q got value %q", field, value)
+		}
+	}
+	if it.Err() != nil {
+		t.Fatalf("Err() = %v, want nil", it.Err())
+	}
+	if len(fields) != 2 || fields[0] != "f1" || fields[1] != "f2" {
+		t.Fatalf("got %v, want [f1 f2]", fields)
+	}
+}
+
+func TestHScanIterator_NoValues(t *testing.T) {
+	fetch := pagedFetcher(map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0": {next: "0", elements: []string{"f1", "f2"}},
+	})
+
+	it := NewHScanIterator(fetch, true)
+	field, value, ok := it.Next(context.Background())
+	if !ok || field != "f1" || value != "" || it.HasValue() {
+		t.Fatalf("got (%q, %q, %v) HasValue=%v, want (f1, \"\", true) HasValue=false", field, value, ok, it.HasValue())
+	}
+}
+
+func TestHScanIterator_ShortPageError(t *testing.T) {
+	fetch := pagedFetcher(map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0": {next: "0", elements: []string{"f1"}},
+	})
+
+	it := NewHScanIterator(fetch, false)
+	if _, _, ok := it.Next(context.Background()); ok {
+		t.Fatal("Next() ok = true, want false for a malformed odd-length page")
+	}
+	if it.Err() != errShortHScanPage {
+		t.Fatalf("Err() = %v, want %v", it.Err(), errShortHScanPage)
+	}
+}
+
+func TestHScanIterator_Close(t *testing.T) {
+	fetch := pagedFetcher(map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0": {next: "3", elements: []string{"f1", "v1"}},
+	})
+
+	it := NewHScanIterator(fetch, false)
+	if err := it.Close(); err != nil {
+		t.Fatalf("Close() = %v, want nil", err)
+	}
+	if _, _, ok := it.Next(context.Background()); ok {
+		t.Fatal("Next() after Close() ok = true, want false")
+	}
+}
+
+func TestHScanIterator_ResumeFromCursor(t *testing.T) {
+	fetch := pagedFetcher(map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0":  {next: "17", elements: []string{"f1", "v1"}},
+		"17": {next: "0", elements: []string{"f2", "v2"}},
+	})
+
+	first := NewHScanIterator(fetch, false)
+	first.Next(context.Background())
+	resumeCursor := first.Cursor()
+	if resumeCursor != "17" {
+		t.Fatalf("Cursor() = %q, want %q", resumeCursor, "17")
+	}
+
+	resumed := NewHScanIteratorFromCursor(fetch, false, resumeCursor)
+	field, _, ok := resumed.Next(context.Background())
+	if !ok || field != "f2" {
+		t.Fatalf("resumed Next() = (%q, ok=%v), want (f2, true)", field, ok)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"fmt"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+)
+
+// HScanOption configures an HScanOptions via NewHScanOptions. See WithHScanMatch,
+// WithHScanCount, and WithNoValues.
+type HScanOption func(*HScanOptions)
+
+// This struct represents the optional arguments for the HSCAN command.
+type HScanOptions struct {
+	BaseScanOptions
+	NoValues bool
+	err      error
+}
+
+// NewHScanOptions creates an HScanOptions, applying each opt in order. Called with no options it
+// returns the zero-value HScanOptions. For example:
+//
+//	options.NewHScanOptions()
+//	options.NewHScanOptions(options.WithHScanMatch("foo*"), options.WithHScanCount(100), options.WithNoValues())
+//
+// opts can be built up once as a []HScanOption and reused across calls. A validation failure
+// (e.g. a negative count from WithHScanCount) is recorded on the options and surfaced by ToArgs.
+func NewHScanOptions(opts ...HScanOption) *HScanOptions {
+	hScanOptions := &HScanOptions{}
+	for _, opt := range opts {
+		opt(hScanOptions)
+	}
+	return hScanOptions
+}
+
+// WithHScanMatch sets the match pattern for the HSCAN command.
+func WithHScanMatch(match string) HScanOption {
+	return func(hScanOptions *HScanOptions) {
+		hScanOptions.BaseScanOptions.SetMatch(match)
+	}
+}
+
+// WithHScanCount sets the count of the HSCAN command. count must be non-negative.
+func WithHScanCount(count int64) HScanOption {
+	return func(hScanOptions *HScanOptions) {
+		if count < 0 {
+			hScanOptions.err = fmt.Errorf("count must be non-negative, got %d", count)
+			return
+		}
+		hScanOptions.BaseScanOptions.SetCount(count)
+	}
+}
+
+// WithNoValues enables the NOVALUES option for the HSCAN command, so field values are not
+// included in the response. Supported from Valkey 8.0.0 and above.
+func WithNoValues() HScanOption {
+	return func(hScanOptions *HScanOptions) {
+		hScanOptions.NoValues = true
+	}
+}
+
+// SetNoValues sets the noValues flag for the HSCAN command.
+// If this value is set to true, the HSCAN command will be called with NOVALUES option.
+// In the NOVALUES option, field values are not included in the response.
+// Supported from Valkey 8.0.0 and above.
+func (hScanOptions *HScanOptions) SetNoValues(noValues bool) *HScanOptions {
+	if noValues {
+		WithNoValues()(hScanOptions)
+	} else {
+		hScanOptions.NoValues = false
+	}
+	return hScanOptions
+}
+
+// SetMatch sets the match pattern for the HSCAN command.
+func (hScanOptions *HScanOptions) SetMatch(match string) *HScanOptions {
+	WithHScanMatch(match)(hScanOptions)
+	return hScanOptions
+}
+
+// SetCount sets the count of the HSCAN command.
+func (hScanOptions *HScanOptions) SetCount(count int64) *HScanOptions {
+	WithHScanCount(count)(hScanOptions)
+	return hScanOptions
+}
+
+func (options *HScanOptions) ToArgs() ([]string, error) {
+	if options.err != nil {
+		return nil, options.err
+	}
+
+	args := []string{}
+	baseArgs, err := options.BaseScanOptions.ToArgs()
+	args = append(args, baseArgs...)
+
+	if options.NoValues {
+		args = append(args, constants.NoValuesKeyword)
+	}
+	return args, err
+}
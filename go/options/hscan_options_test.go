@@ -0,0 +1,55 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "testing"
+
+func TestNewHScanOptions_Empty(t *testing.T) {
+	got := NewHScanOptions()
+	if got.NoValues {
+		t.Fatal("NoValues = true for empty NewHScanOptions(), want false")
+	}
+	if _, err := got.ToArgs(); err != nil {
+		t.Fatalf("ToArgs() error = %v, want nil", err)
+	}
+}
+
+func TestNewHScanOptions_AppliesAllOpts(t *testing.T) {
+	opts := NewHScanOptions(WithHScanMatch("foo*"), WithHScanCount(100), WithNoValues())
+	if !opts.NoValues {
+		t.Fatal("NoValues = false, want true")
+	}
+	if _, err := opts.ToArgs(); err != nil {
+		t.Fatalf("ToArgs() error = %v, want nil", err)
+	}
+}
+
+func TestWithHScanCount_RejectsNegative(t *testing.T) {
+	opts := NewHScanOptions(WithHScanCount(-1))
+	if _, err := opts.ToArgs(); err == nil {
+		t.Fatal("ToArgs() error = nil, want error for negative count")
+	}
+}
+
+func TestHScanOptions_FluentSetNoValuesMatchesFunctionalOption(t *testing.T) {
+	fluent := NewHScanOptions().SetNoValues(true)
+	functional := NewHScanOptions(WithNoValues())
+	if fluent.NoValues != functional.NoValues {
+		t.Fatalf("fluent.NoValues = %v, functional.NoValues = %v, want equal", fluent.NoValues, functional.NoValues)
+	}
+}
+
+func TestHScanOptions_SetNoValuesFalseClearsFlag(t *testing.T) {
+	opts := NewHScanOptions(WithNoValues())
+	opts.SetNoValues(false)
+	if opts.NoValues {
+		t.Fatal("NoValues = true after SetNoValues(false), want false")
+	}
+}
+
+func TestHScanOptions_SetCountRejectsNegative(t *testing.T) {
+	opts := NewHScanOptions().SetCount(-1)
+	if _, err := opts.ToArgs(); err == nil {
+		t.Fatal("ToArgs() error = nil, want error for negative count set via fluent setter")
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "context"
+
+// ScanFetcher issues a single SCAN-family round trip starting at cursor and returns the cursor
+// to resume from along with the raw elements of the reply. Implementations wrap the actual
+// ZSCAN/HSCAN/SSCAN/SCAN client call; cursorPager and the iterators built on it only deal with
+// cursor bookkeeping, buffering, and decoding.
+type ScanFetcher func(ctx context.Context, cursor string) (nextCursor string, elements []string, err error)
+
+// cursorPager implements the page-buffering and cursor-wrap logic shared by every SCAN-family
+// iterator (ZSCAN, HSCAN, SSCAN, SCAN). Concrete iterators hold one in a field and layer their own
+// element-decoding step for their command's reply shape.
+type cursorPager struct {
+	fetch ScanFetcher
+
+	cursor  string
+	started bool
+	buffer  []string
+
+	closed bool
+	err    error
+}
+
+// newCursorPager creates a pager that starts fetching from startCursor. Pass "0" (or "") to
+// start a fresh scan, or a cursor returned by an earlier pager's Cursor() to resume one.
+func newCursorPager(fetch ScanFetcher, startCursor string) cursorPager {
+	if startCursor == "" {
+		startCursor = "0"
+	}
+	return cursorPager{fetch: fetch, cursor: startCursor}
+}
+
+// fill ensures the buffer holds at least one undecoded element, fetching another page if
+// needed. It returns false once the scan is exhausted, the pager was closed, or fetching failed;
+// callers should check Err() to distinguish exhaustion from failure.
+func (p *cursorPager) fill(ctx context.Context) bool {
+	for {
+		if p.closed || p.err != nil {
+			return false
+		}
+		if len(p.buffer) > 0 {
+			return true
+		}
+		if p.started && p.cursor == "0" {
+			return false
+		}
+
+		nextCursor, elements, err := p.fetch(ctx, p.cursor)
+		if err != nil {
+			p.err = err
+			return false
+		}
+
+		p.started = true
+		p.cursor = nextCursor
+		p.buffer = elements
+
+		if len(p.buffer) == 0 && p.cursor == "0" {
+			return false
+		}
+	}
+}
+
+// Err returns the first error encountered while fetching a page, if any.
+func (p *cursorPager) Err() error {
+	return p.err
+}
+
+// Close stops the pager early. Further calls to fill return false.
+func (p *cursorPager) Close() error {
+	p.closed = true
+	return nil
+}
+
+// Cursor returns the raw cursor the pager will resume from on its next fetch. Persist it and
+// pass it as startCursor to a new pager (via the iterator's *FromCursor constructor) to
+// checkpoint and resume a scan across processes.
+func (p *cursorPager) Cursor() string {
+	return p.cursor
+}
@@ -0,0 +1,140 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "context"
+
+// ScanIterator hides SCAN cursor management from the caller for a single node. It reissues SCAN
+// with the cursor returned by the previous call, buffering each page, until the cursor wraps
+// back to "0". Create one with NewScanIterator or NewScanIteratorFromCursor. For cluster mode,
+// where SCAN must be fanned out across every node, use ClusterScanIterator instead.
+type ScanIterator struct {
+	pager cursorPager
+}
+
+// NewScanIterator creates a ScanIterator that pages through SCAN results via fetch, starting a
+// fresh scan at cursor "0".
+func NewScanIterator(fetch ScanFetcher) *ScanIterator {
+	return NewScanIteratorFromCursor(fetch, "0")
+}
+
+// NewScanIteratorFromCursor creates a ScanIterator that resumes a scan from startCursor, e.g.
+// one previously returned by another ScanIterator's Cursor().
+func NewScanIteratorFromCursor(fetch ScanFetcher, startCursor string) *ScanIterator {
+	return &ScanIterator{pager: newCursorPager(fetch, startCursor)}
+}
+
+// Next advances the iterator and returns the next key. ok is false once the scan is exhausted,
+// the iterator was closed, or an error occurred; callers should check Err() in that case.
+func (it *ScanIterator) Next(ctx context.Context) (key string, ok bool) {
+	if !it.pager.fill(ctx) {
+		return "", false
+	}
+	key = it.pager.buffer[0]
+	it.pager.buffer = it.pager.buffer[1:]
+	return key, true
+}
+
+// Err returns the first error encountered while fetching a page, if any.
+func (it *ScanIterator) Err() error {
+	return it.pager.Err()
+}
+
+// Close stops the iterator early. Further calls to Next return false.
+func (it *ScanIterator) Close() error {
+	return it.pager.Close()
+}
+
+// Cursor returns the raw SCAN cursor the iterator will resume from on the next fetch. Persist it
+// and pass it to NewScanIteratorFromCursor to checkpoint and resume a scan across processes.
+func (it *ScanIterator) Cursor() string {
+	return it.pager.Cursor()
+}
+
+// NodeScanFetcher pairs a cluster node identifier with the ScanFetcher that issues SCAN against
+// that node only.
+type NodeScanFetcher struct {
+	NodeID string
+	Fetch  ScanFetcher
+}
+
+// ClusterScanIterator fans SCAN out across every node of a cluster. It exhausts one node's
+// cursor before moving to the next, exposing the same Next/Err/Close/Cursor shape as
+// ScanIterator. Create one with NewClusterScanIterator, supplying one NodeScanFetcher per node.
+type ClusterScanIterator struct {
+	nodes   []NodeScanFetcher
+	current int
+	iter    *ScanIterator
+	err     error
+	closed  bool
+}
+
+// NewClusterScanIterator creates a ClusterScanIterator that scans each of nodes in order.
+func NewClusterScanIterator(nodes []NodeScanFetcher) *ClusterScanIterator {
+	it := &ClusterScanIterator{nodes: nodes}
+	it.startCurrentNode("0")
+	return it
+}
+
+func (it *ClusterScanIterator) startCurrentNode(startCursor string) {
+	if it.current >= len(it.nodes) {
+		it.iter = nil
+		return
+	}
+	it.iter = NewScanIteratorFromCursor(it.nodes[it.current].Fetch, startCursor)
+}
+
+// Next advances the iterator, moving on to the next node once the current node's cursor wraps.
+// ok is false once every node has been exhausted, the iterator was closed, or an error occurred;
+// callers should check Err() in that case.
+func (it *ClusterScanIterator) Next(ctx context.Context) (key string, ok bool) {
+	for {
+		if it.closed || it.err != nil || it.iter == nil {
+			return "", false
+		}
+
+		if key, ok := it.iter.Next(ctx); ok {
+			return key, true
+		}
+		if err := it.iter.Err(); err != nil {
+			it.err = err
+			return "", false
+		}
+
+		it.current++
+		it.startCurrentNode("0")
+	}
+}
+
+// Err returns the first error encountered while scanning any node, if any.
+func (it *ClusterScanIterator) Err() error {
+	return it.err
+}
+
+// Close stops the iterator early. Further calls to Next return false.
+func (it *ClusterScanIterator) Close() error {
+	it.closed = true
+	if it.iter != nil {
+		return it.iter.Close()
+	}
+	return nil
+}
+
+// CurrentNode returns the ID of the node currently being scanned, or "" once every node has been
+// exhausted. Paired with Cursor(), it lets a caller checkpoint and resume a cluster-wide scan by
+// recording which node to resume and at what cursor.
+func (it *ClusterScanIterator) CurrentNode() string {
+	if it.current >= len(it.nodes) {
+		return ""
+	}
+	return it.nodes[it.current].NodeID
+}
+
+// Cursor returns the raw SCAN cursor for the node currently being scanned, or "0" once every
+// node has been exhausted.
+func (it *ClusterScanIterator) Cursor() string {
+	if it.iter == nil {
+		return "0"
+	}
+	return it.iter.Cursor()
+}
@@ -0,0 +1,90 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestScanIterator_PagesAcrossCursors(t *testing.T) {
+	fetch := pagedFetcher(map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0":  {next: "17", elements: []string{"key1"}},
+		"17": {next: "0", elements: []string{"key2"}},
+	})
+
+	it := NewScanIterator(fetch)
+	var got []string
+	for {
+		key, ok := it.Next(context.Background())
+		if !ok {
+			break
+		}
+		got = append(got, key)
+	}
+	if it.Err() != nil {
+		t.Fatalf("Err() = %v, want nil", it.Err())
+	}
+	if len(got) != 2 || got[0] != "key1" || got[1] != "key2" {
+		t.Fatalf("got %v, want [key1 key2]", got)
+	}
+}
+
+func TestScanIterator_PropagatesFetchError(t *testing.T) {
+	wantErr := errors.New("boom")
+	fetch := func(_ context.Context, _ string) (string, []string, error) {
+		return "", nil, wantErr
+	}
+
+	it := NewScanIterator(fetch)
+	if _, ok := it.Next(context.Background()); ok {
+		t.Fatal("Next() ok = true, want false")
+	}
+	if it.Err() != wantErr {
+		t.Fatalf("Err() = %v, want %v", it.Err(), wantErr)
+	}
+}
+
+func TestScanIterator_Close(t *testing.T) {
+	fetch := pagedFetcher(map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0": {next: "3", elements: []string{"key1"}},
+	})
+
+	it := NewScanIterator(fetch)
+	if err := it.Close(); err != nil {
+		t.Fatalf("Close() = %v, want nil", err)
+	}
+	if _, ok := it.Next(context.Background()); ok {
+		t.Fatal("Next() after Close() ok = true, want false")
+	}
+}
+
+func TestScanIterator_ResumeFromCursor(t *testing.T) {
+	fetch := pagedFetcher(map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0":  {next: "17", elements: []string{"key1"}},
+		"17": {next: "0", elements: []string{"key2"}},
+	})
+
+	first := NewScanIterator(fetch)
+	first.Next(context.Background())
+	resumeCursor := first.Cursor()
+	if resumeCursor != "17" {
+		t.Fatalf("Cursor() = %q, want %q", resumeCursor, "17")
+	}
+
+	resumed := NewScanIteratorFromCursor(fetch, resumeCursor)
+	key, ok := resumed.Next(context.Background())
+	if !ok || key != "key2" {
+		t.Fatalf("resumed Next() = (%q, ok=%v), want (key2, true)", key, ok)
+	}
+}
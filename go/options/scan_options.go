@@ -0,0 +1,94 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"fmt"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+)
+
+// ScanOption configures a ScanOptions via NewScanOptions. See WithScanMatch, WithScanCount, and
+// WithScanType.
+type ScanOption func(*ScanOptions)
+
+// This struct represents the optional arguments for the SCAN command.
+type ScanOptions struct {
+	BaseScanOptions
+	Type string
+	err  error
+}
+
+// NewScanOptions creates a ScanOptions, applying each opt in order. Called with no options it
+// returns the zero-value ScanOptions. For example:
+//
+//	options.NewScanOptions()
+//	options.NewScanOptions(options.WithScanMatch("foo*"), options.WithScanCount(100), options.WithScanType("string"))
+//
+// opts can be built up once as a []ScanOption and reused across calls. A validation failure
+// (e.g. a negative count from WithScanCount) is recorded on the options and surfaced by ToArgs.
+func NewScanOptions(opts ...ScanOption) *ScanOptions {
+	scanOptions := &ScanOptions{}
+	for _, opt := range opts {
+		opt(scanOptions)
+	}
+	return scanOptions
+}
+
+// WithScanMatch sets the match pattern for the SCAN command.
+func WithScanMatch(match string) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.BaseScanOptions.SetMatch(match)
+	}
+}
+
+// WithScanCount sets the count of the SCAN command. count must be non-negative.
+func WithScanCount(count int64) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		if count < 0 {
+			scanOptions.err = fmt.Errorf("count must be non-negative, got %d", count)
+			return
+		}
+		scanOptions.BaseScanOptions.SetCount(count)
+	}
+}
+
+// WithScanType restricts the SCAN command to keys of the given type (e.g. "string", "list", "set").
+func WithScanType(objectType string) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.Type = objectType
+	}
+}
+
+// SetMatch sets the match pattern for the SCAN command.
+func (scanOptions *ScanOptions) SetMatch(match string) *ScanOptions {
+	WithScanMatch(match)(scanOptions)
+	return scanOptions
+}
+
+// SetCount sets the count of the SCAN command.
+func (scanOptions *ScanOptions) SetCount(count int64) *ScanOptions {
+	WithScanCount(count)(scanOptions)
+	return scanOptions
+}
+
+// SetType sets the TYPE filter for the SCAN command.
+func (scanOptions *ScanOptions) SetType(objectType string) *ScanOptions {
+	WithScanType(objectType)(scanOptions)
+	return scanOptions
+}
+
+func (options *ScanOptions) ToArgs() ([]string, error) {
+	if options.err != nil {
+		return nil, options.err
+	}
+
+	args := []string{}
+	baseArgs, err := options.BaseScanOptions.ToArgs()
+	args = append(args, baseArgs...)
+
+	if options.Type != "" {
+		args = append(args, constants.TypeKeyword, options.Type)
+	}
+	return args, err
+}
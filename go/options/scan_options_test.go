@@ -0,0 +1,47 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "testing"
+
+func TestNewScanOptions_Empty(t *testing.T) {
+	got := NewScanOptions()
+	if got.Type != "" {
+		t.Fatalf("Type = %q for empty NewScanOptions(), want empty", got.Type)
+	}
+	if _, err := got.ToArgs(); err != nil {
+		t.Fatalf("ToArgs() error = %v, want nil", err)
+	}
+}
+
+func TestNewScanOptions_AppliesAllOpts(t *testing.T) {
+	opts := NewScanOptions(WithScanMatch("foo*"), WithScanCount(100), WithScanType("string"))
+	if opts.Type != "string" {
+		t.Fatalf("Type = %q, want %q", opts.Type, "string")
+	}
+	if _, err := opts.ToArgs(); err != nil {
+		t.Fatalf("ToArgs() error = %v, want nil", err)
+	}
+}
+
+func TestWithScanCount_RejectsNegative(t *testing.T) {
+	opts := NewScanOptions(WithScanCount(-1))
+	if _, err := opts.ToArgs(); err == nil {
+		t.Fatal("ToArgs() error = nil, want error for negative count")
+	}
+}
+
+func TestScanOptions_FluentSetTypeMatchesFunctionalOption(t *testing.T) {
+	fluent := NewScanOptions().SetType("list")
+	functional := NewScanOptions(WithScanType("list"))
+	if fluent.Type != functional.Type {
+		t.Fatalf("fluent.Type = %q, functional.Type = %q, want equal", fluent.Type, functional.Type)
+	}
+}
+
+func TestScanOptions_SetCountRejectsNegative(t *testing.T) {
+	opts := NewScanOptions().SetCount(-1)
+	if _, err := opts.ToArgs(); err == nil {
+		t.Fatal("ToArgs() error = nil, want error for negative count set via fluent setter")
+	}
+}
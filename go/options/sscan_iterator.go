@@ -0,0 +1,52 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "context"
+
+// SScanIterator hides SSCAN cursor management from the caller. It reissues SSCAN with the
+// cursor returned by the previous call, buffering each page, until the cursor wraps back to
+// "0". Create one with NewSScanIterator or NewSScanIteratorFromCursor.
+type SScanIterator struct {
+	pager cursorPager
+}
+
+// NewSScanIterator creates an SScanIterator that pages through SSCAN results via fetch, starting
+// a fresh scan at cursor "0".
+func NewSScanIterator(fetch ScanFetcher) *SScanIterator {
+	return NewSScanIteratorFromCursor(fetch, "0")
+}
+
+// NewSScanIteratorFromCursor creates an SScanIterator that resumes a scan from startCursor, e.g.
+// one previously returned by another SScanIterator's Cursor().
+func NewSScanIteratorFromCursor(fetch ScanFetcher, startCursor string) *SScanIterator {
+	return &SScanIterator{pager: newCursorPager(fetch, startCursor)}
+}
+
+// Next advances the iterator and returns the next member. ok is false once the scan is
+// exhausted, the iterator was closed, or an error occurred; callers should check Err() in that
+// case.
+func (it *SScanIterator) Next(ctx context.Context) (member string, ok bool) {
+	if !it.pager.fill(ctx) {
+		return "", false
+	}
+	member = it.pager.buffer[0]
+	it.pager.buffer = it.pager.buffer[1:]
+	return member, true
+}
+
+// Err returns the first error encountered while fetching a page, if any.
+func (it *SScanIterator) Err() error {
+	return it.pager.Err()
+}
+
+// Close stops the iterator early. Further calls to Next return false.
+func (it *SScanIterator) Close() error {
+	return it.pager.Close()
+}
+
+// Cursor returns the raw SSCAN cursor the iterator will resume from on the next fetch. Persist
+// it and pass it to NewSScanIteratorFromCursor to checkpoint and resume a scan across processes.
+func (it *SScanIterator) Cursor() string {
+	return it.pager.Cursor()
+}
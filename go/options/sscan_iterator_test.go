@@ -0,0 +1,90 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSScanIterator_PagesAcrossCursors(t *testing.T) {
+	fetch := pagedFetcher(map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0":  {next: "17", elements: []string{"a"}},
+		"17": {next: "0", elements: []string{"b"}},
+	})
+
+	it := NewSScanIterator(fetch)
+	var got []string
+	for {
+		member, ok := it.Next(context.Background())
+		if !ok {
+			break
+		}
+		got = append(got, member)
+	}
+	if it.Err() != nil {
+		t.Fatalf("Err() = %v, want nil", it.Err())
+	}
+	if len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Fatalf("got %v, want [a b]", got)
+	}
+}
+
+func TestSScanIterator_PropagatesFetchError(t *testing.T) {
+	wantErr := errors.New("boom")
+	fetch := func(_ context.Context, _ string) (string, []string, error) {
+		return "", nil, wantErr
+	}
+
+	it := NewSScanIterator(fetch)
+	if _, ok := it.Next(context.Background()); ok {
+		t.Fatal("Next() ok = true, want false")
+	}
+	if it.Err() != wantErr {
+		t.Fatalf("Err() = %v, want %v", it.Err(), wantErr)
+	}
+}
+
+func TestSScanIterator_Close(t *testing.T) {
+	fetch := pagedFetcher(map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0": {next: "3", elements: []string{"a"}},
+	})
+
+	it := NewSScanIterator(fetch)
+	if err := it.Close(); err != nil {
+		t.Fatalf("Close() = %v, want nil", err)
+	}
+	if _, ok := it.Next(context.Background()); ok {
+		t.Fatal("Next() after Close() ok = true, want false")
+	}
+}
+
+func TestSScanIterator_ResumeFromCursor(t *testing.T) {
+	fetch := pagedFetcher(map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0":  {next: "17", elements: []string{"a"}},
+		"17": {next: "0", elements: []string{"b"}},
+	})
+
+	first := NewSScanIterator(fetch)
+	first.Next(context.Background())
+	resumeCursor := first.Cursor()
+	if resumeCursor != "17" {
+		t.Fatalf("Cursor() = %q, want %q", resumeCursor, "17")
+	}
+
+	resumed := NewSScanIteratorFromCursor(fetch, resumeCursor)
+	member, ok := resumed.Next(context.Background())
+	if !ok || member != "b" {
+		t.Fatalf("resumed Next() = (%q, ok=%v), want (b, true)", member, ok)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "fmt"
+
+// SScanOption configures an SScanOptions via NewSScanOptions. See WithSScanMatch and
+// WithSScanCount.
+type SScanOption func(*SScanOptions)
+
+// This struct represents the optional arguments for the SSCAN command.
+type SScanOptions struct {
+	BaseScanOptions
+	err error
+}
+
+// NewSScanOptions creates an SScanOptions, applying each opt in order. Called with no options it
+// returns the zero-value SScanOptions. For example:
+//
+//	options.NewSScanOptions()
+//	options.NewSScanOptions(options.WithSScanMatch("foo*"), options.WithSScanCount(100))
+//
+// opts can be built up once as a []SScanOption and reused across calls. A validation failure
+// (e.g. a negative count from WithSScanCount) is recorded on the options and surfaced by ToArgs.
+func NewSScanOptions(opts ...SScanOption) *SScanOptions {
+	sScanOptions := &SScanOptions{}
+	for _, opt := range opts {
+		opt(sScanOptions)
+	}
+	return sScanOptions
+}
+
+// WithSScanMatch sets the match pattern for the SSCAN command.
+func WithSScanMatch(match string) SScanOption {
+	return func(sScanOptions *SScanOptions) {
+		sScanOptions.BaseScanOptions.SetMatch(match)
+	}
+}
+
+// WithSScanCount sets the count of the SSCAN command. count must be non-negative.
+func WithSScanCount(count int64) SScanOption {
+	return func(sScanOptions *SScanOptions) {
+		if count < 0 {
+			sScanOptions.err = fmt.Errorf("count must be non-negative, got %d", count)
+			return
+		}
+		sScanOptions.BaseScanOptions.SetCount(count)
+	}
+}
+
+// SetMatch sets the match pattern for the SSCAN command.
+func (sScanOptions *SScanOptions) SetMatch(match string) *SScanOptions {
+	WithSScanMatch(match)(sScanOptions)
+	return sScanOptions
+}
+
+// SetCount sets the count of the SSCAN command.
+func (sScanOptions *SScanOptions) SetCount(count int64) *SScanOptions {
+	WithSScanCount(count)(sScanOptions)
+	return sScanOptions
+}
+
+func (options *SScanOptions) ToArgs() ([]string, error) {
+	if options.err != nil {
+		return nil, options.err
+	}
+	return options.BaseScanOptions.ToArgs()
+}
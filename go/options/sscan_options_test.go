@@ -0,0 +1,40 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "testing"
+
+func TestNewSScanOptions_Empty(t *testing.T) {
+	got := NewSScanOptions()
+	if _, err := got.ToArgs(); err != nil {
+		t.Fatalf("ToArgs() error = %v, want nil", err)
+	}
+}
+
+func TestNewSScanOptions_AppliesAllOpts(t *testing.T) {
+	opts := NewSScanOptions(WithSScanMatch("foo*"), WithSScanCount(100))
+	if _, err := opts.ToArgs(); err != nil {
+		t.Fatalf("ToArgs() error = %v, want nil", err)
+	}
+}
+
+func TestWithSScanCount_RejectsNegative(t *testing.T) {
+	opts := NewSScanOptions(WithSScanCount(-1))
+	if _, err := opts.ToArgs(); err == nil {
+		t.Fatal("ToArgs() error = nil, want error for negative count")
+	}
+}
+
+func TestSScanOptions_SetCountRejectsNegative(t *testing.T) {
+	opts := NewSScanOptions().SetCount(-1)
+	if _, err := opts.ToArgs(); err == nil {
+		t.Fatal("ToArgs() error = nil, want error for negative count set via fluent setter")
+	}
+}
+
+func TestSScanOptions_SetMatchDoesNotError(t *testing.T) {
+	opts := NewSScanOptions().SetMatch("foo*")
+	if _, err := opts.ToArgs(); err != nil {
+		t.Fatalf("ToArgs() error = %v, want nil", err)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"context"
+	"errors"
+)
+
+// errShortZScanPage indicates the server returned a malformed ZSCAN page whose element count
+// doesn't match the expected member-only or member/score pair shape.
+var errShortZScanPage = errors.New("zscan: malformed page, element count does not match NoScores setting")
+
+// ZScanIterator hides ZSCAN cursor management from the caller. It reissues ZSCAN with the
+// cursor returned by the previous call, buffering each page, until the cursor wraps back to
+// "0". Create one with NewZScanIterator or NewZScanIteratorFromCursor.
+type ZScanIterator struct {
+	pager    cursorPager
+	noScores bool
+
+	lastHasScore bool
+}
+
+// NewZScanIterator creates a ZScanIterator that pages through ZSCAN results via fetch, starting
+// a fresh scan at cursor "0". noScores must match the NoScores setting of the ZScanOptions used
+// to build fetch, since it controls whether buffered elements are decoded as member/score pairs
+// or members only.
+func NewZScanIterator(fetch ScanFetcher, noScores bool) *ZScanIterator {
+	return NewZScanIteratorFromCursor(fetch, noScores, "0")
+}
+
+// NewZScanIteratorFromCursor creates a ZScanIterator that resumes a scan from startCursor, e.g.
+// one previously returned by another ZScanIterator's Cursor(). This is how a scan is checkpointed
+// and continued across processes.
+func NewZScanIteratorFromCursor(fetch ScanFetcher, noScores bool, startCursor string) *ZScanIterator {
+	return &ZScanIterator{pager: newCursorPager(fetch, startCursor), noScores: noScores}
+}
+
+// Next advances the iterator and returns the next member and its score. ok is false once the
+// scan is exhausted, the iterator was closed, or an error occurred; callers should check Err()
+// in that case. When the iterator was created with noScores, score is always 0 and HasScore()
+// reports false for the element just returned.
+func (it *ZScanIterator) Next(ctx context.Context) (member string, score float64, ok bool) {
+	if !it.pager.fill(ctx) {
+		return "", 0, false
+	}
+
+	step := 1
+	if !it.noScores {
+		step = 2
+	}
+	if len(it.pager.buffer) < step {
+		it.pager.err = errShortZScanPage
+		return "", 0, false
+	}
+
+	decoded, err := NewZScanResult("", it.pager.buffer[:step], it.noScores)
+	if err != nil {
+		it.pager.err = err
+		return "", 0, false
+	}
+	it.pager.buffer = it.pager.buffer[step:]
+
+	entry := decoded.Members[0]
+	it.lastHasScore = entry.ScorePresent
+	return entry.Member, entry.Score, true
+}
+
+// HasScore reports whether the element returned by the most recent call to Next carried a
+// score. It is always false when the iterator was created with noScores.
+func (it *ZScanIterator) HasScore() bool {
+	return it.lastHasScore
+}
+
+// Err returns the first error encountered while fetching or decoding a page, if any.
+func (it *ZScanIterator) Err() error {
+	return it.pager.Err()
+}
+
+// Close stops the iterator early. Further calls to Next return false.
+func (it *ZScanIterator) Close() error {
+	return it.pager.Close()
+}
+
+// Cursor returns the raw ZSCAN cursor the iterator will resume from on the next fetch. Persist
+// it and pass it to NewZScanIteratorFromCursor to checkpoint and resume a scan across processes.
+func (it *ZScanIterator) Cursor() string {
+	return it.pager.Cursor()
+}
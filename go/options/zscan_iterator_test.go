@@ -0,0 +1,160 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func pagedFetcher(pages map[string]struct {
+	next     string
+	elements []string
+}) ScanFetcher {
+	return func(_ context.Context, cursor string) (string, []string, error) {
+		page, ok := pages[cursor]
+		if !ok {
+			return "0", nil, nil
+		}
+		return page.next, page.elements, nil
+	}
+}
+
+func TestZScanIterator_PagesAcrossCursors(t *testing.T) {
+	fetch := pagedFetcher(map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0":  {next: "17", elements: []string{"a", "1"}},
+		"17": {next: "0", elements: []string{"b", "2"}},
+	})
+
+	it := NewZScanIterator(fetch, false)
+	var got []string
+	for {
+		member, score, ok := it.Next(context.Background())
+		if !ok {
+			break
+		}
+		got = append(got, member)
+		if !it.HasScore() {
+			t.Errorf("HasScore() = false, want true for member %q", member)
+		}
+		_ = score
+	}
+	if it.Err() != nil {
+		t.Fatalf("Err() = %v, want nil", it.Err())
+	}
+	if len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Fatalf("got %v, want [a b]", got)
+	}
+}
+
+func TestZScanIterator_NoScores(t *testing.T) {
+	fetch := pagedFetcher(map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0": {next: "0", elements: []string{"a", "b"}},
+	})
+
+	it := NewZScanIterator(fetch, true)
+	member, score, ok := it.Next(context.Background())
+	if !ok || member != "a" || score != 0 || it.HasScore() {
+		t.Fatalf("got (%q, %v, %v) HasScore=%v, want (a, 0, true) HasScore=false", member, score, ok, it.HasScore())
+	}
+}
+
+func TestZScanIterator_PropagatesFetchError(t *testing.T) {
+	wantErr := errors.New("boom")
+	fetch := func(_ context.Context, _ string) (string, []string, error) {
+		return "", nil, wantErr
+	}
+
+	it := NewZScanIterator(fetch, false)
+	if _, _, ok := it.Next(context.Background()); ok {
+		t.Fatal("Next() ok = true, want false")
+	}
+	if it.Err() != wantErr {
+		t.Fatalf("Err() = %v, want %v", it.Err(), wantErr)
+	}
+}
+
+func TestZScanIterator_Close(t *testing.T) {
+	fetch := pagedFetcher(map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0": {next: "3", elements: []string{"a", "1"}},
+	})
+
+	it := NewZScanIterator(fetch, false)
+	if err := it.Close(); err != nil {
+		t.Fatalf("Close() = %v, want nil", err)
+	}
+	if _, _, ok := it.Next(context.Background()); ok {
+		t.Fatal("Next() after Close() ok = true, want false")
+	}
+}
+
+func TestZScanIterator_ResumeFromCursor(t *testing.T) {
+	fetch := pagedFetcher(map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0":  {next: "17", elements: []string{"a", "1"}},
+		"17": {next: "0", elements: []string{"b", "2"}},
+	})
+
+	first := NewZScanIterator(fetch, false)
+	first.Next(context.Background())
+	resumeCursor := first.Cursor()
+	if resumeCursor != "17" {
+		t.Fatalf("Cursor() = %q, want %q", resumeCursor, "17")
+	}
+
+	resumed := NewZScanIteratorFromCursor(fetch, false, resumeCursor)
+	member, _, ok := resumed.Next(context.Background())
+	if !ok || member != "b" {
+		t.Fatalf("resumed Next() = (%q, ok=%v), want (b, true)", member, ok)
+	}
+}
+
+func TestClusterScanIterator_FansOutAcrossNodes(t *testing.T) {
+	nodeA := pagedFetcher(map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0": {next: "0", elements: []string{"a-key"}},
+	})
+	nodeB := pagedFetcher(map[string]struct {
+		next     string
+		elements []string
+	}{
+		"0": {next: "0", elements: []string{"b-key"}},
+	})
+
+	it := NewClusterScanIterator([]NodeScanFetcher{
+		{NodeID: "node-a", Fetch: nodeA},
+		{NodeID: "node-b", Fetch: nodeB},
+	})
+
+	var got []string
+	for {
+		key, ok := it.Next(context.Background())
+		if !ok {
+			break
+		}
+		got = append(got, key)
+	}
+	if it.Err() != nil {
+		t.Fatalf("Err() = %v, want nil", it.Err())
+	}
+	if len(got) != 2 || got[0] != "a-key" || got[1] != "b-key" {
+		t.Fatalf("got %v, want [a-key b-key]", got)
+	}
+	if it.CurrentNode() != "" {
+		t.Fatalf("CurrentNode() = %q, want empty after exhaustion", it.CurrentNode())
+	}
+}
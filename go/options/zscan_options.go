@@ -2,16 +2,63 @@
 
 package options
 
-import "github.com/valkey-io/valkey-glide/go/v2/constants"
+import (
+	"fmt"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+)
+
+// ZScanOption configures a ZScanOptions via NewZScanOptions. See WithZScanMatch, WithZScanCount,
+// and WithNoScores.
+type ZScanOption func(*ZScanOptions)
 
 // This struct represents the optional arguments for the ZSCAN command.
 type ZScanOptions struct {
 	BaseScanOptions
 	NoScores bool
+	err      error
+}
+
+// NewZScanOptions creates a ZScanOptions, applying each opt in order. Called with no options it
+// returns the zero-value ZScanOptions. For example:
+//
+//	options.NewZScanOptions()
+//	options.NewZScanOptions(options.WithZScanMatch("foo*"), options.WithZScanCount(100), options.WithNoScores())
+//
+// opts can be built up once as a []ZScanOption and reused across calls. A validation failure
+// (e.g. a negative count from WithZScanCount) is recorded on the options and surfaced by ToArgs.
+func NewZScanOptions(opts ...ZScanOption) *ZScanOptions {
+	zScanOptions := &ZScanOptions{}
+	for _, opt := range opts {
+		opt(zScanOptions)
+	}
+	return zScanOptions
+}
+
+// WithZScanMatch sets the match pattern for the ZSCAN command.
+func WithZScanMatch(match string) ZScanOption {
+	return func(zScanOptions *ZScanOptions) {
+		zScanOptions.BaseScanOptions.SetMatch(match)
+	}
+}
+
+// WithZScanCount sets the count of the ZSCAN command. count must be non-negative.
+func WithZScanCount(count int64) ZScanOption {
+	return func(zScanOptions *ZScanOptions) {
+		if count < 0 {
+			zScanOptions.err = fmt.Errorf("count must be non-negative, got %d", count)
+			return
+		}
+		zScanOptions.BaseScanOptions.SetCount(count)
+	}
 }
 
-func NewZScanOptions() *ZScanOptions {
-	return &ZScanOptions{}
+// WithNoScores enables the NOSCORES option for the ZSCAN command, so scores are not included in
+// the response. Supported from Valkey 8.0.0 and above.
+func WithNoScores() ZScanOption {
+	return func(zScanOptions *ZScanOptions) {
+		zScanOptions.NoScores = true
+	}
 }
 
 // SetNoScores sets the noScores flag for the ZSCAN command.
@@ -19,23 +66,31 @@ func NewZScanOptions() *ZScanOptions {
 // In the NOSCORES option, scores are not included in the response.
 // Supported from Valkey 8.0.0 and above.
 func (zScanOptions *ZScanOptions) SetNoScores(noScores bool) *ZScanOptions {
-	zScanOptions.NoScores = noScores
+	if noScores {
+		WithNoScores()(zScanOptions)
+	} else {
+		zScanOptions.NoScores = false
+	}
 	return zScanOptions
 }
 
 // SetMatch sets the match pattern for the ZSCAN command.
 func (zScanOptions *ZScanOptions) SetMatch(match string) *ZScanOptions {
-	zScanOptions.BaseScanOptions.SetMatch(match)
+	WithZScanMatch(match)(zScanOptions)
 	return zScanOptions
 }
 
 // SetCount sets the count of the ZSCAN command.
 func (zScanOptions *ZScanOptions) SetCount(count int64) *ZScanOptions {
-	zScanOptions.BaseScanOptions.SetCount(count)
+	WithZScanCount(count)(zScanOptions)
 	return zScanOptions
 }
 
 func (options *ZScanOptions) ToArgs() ([]string, error) {
+	if options.err != nil {
+		return nil, options.err
+	}
+
 	args := []string{}
 	baseArgs, err := options.BaseScanOptions.ToArgs()
 	args = append(args, baseArgs...)
@@ -0,0 +1,55 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "testing"
+
+func TestNewZScanOptions_Empty(t *testing.T) {
+	got := NewZScanOptions()
+	if got.NoScores {
+		t.Fatal("NoScores = true for empty NewZScanOptions(), want false")
+	}
+	if _, err := got.ToArgs(); err != nil {
+		t.Fatalf("ToArgs() error = %v, want nil", err)
+	}
+}
+
+func TestNewZScanOptions_AppliesAllOpts(t *testing.T) {
+	opts := NewZScanOptions(WithZScanMatch("foo*"), WithZScanCount(100), WithNoScores())
+	if !opts.NoScores {
+		t.Fatal("NoScores = false, want true")
+	}
+	if _, err := opts.ToArgs(); err != nil {
+		t.Fatalf("ToArgs() error = %v, want nil", err)
+	}
+}
+
+func TestWithZScanCount_RejectsNegative(t *testing.T) {
+	opts := NewZScanOptions(WithZScanCount(-1))
+	if _, err := opts.ToArgs(); err == nil {
+		t.Fatal("ToArgs() error = nil, want error for negative count")
+	}
+}
+
+func TestZScanOptions_FluentSetNoScoresMatchesFunctionalOption(t *testing.T) {
+	fluent := NewZScanOptions().SetNoScores(true)
+	functional := NewZScanOptions(WithNoScores())
+	if fluent.NoScores != functional.NoScores {
+		t.Fatalf("fluent.NoScores = %v, functional.NoScores = %v, want equal", fluent.NoScores, functional.NoScores)
+	}
+}
+
+func TestZScanOptions_SetNoScoresFalseClearsFlag(t *testing.T) {
+	opts := NewZScanOptions(WithNoScores())
+	opts.SetNoScores(false)
+	if opts.NoScores {
+		t.Fatal("NoScores = true after SetNoScores(false), want false")
+	}
+}
+
+func TestZScanOptions_SetCountRejectsNegative(t *testing.T) {
+	opts := NewZScanOptions().SetCount(-1)
+	if _, err := opts.ToArgs(); err == nil {
+		t.Fatal("ToArgs() error = nil, want error for negative count set via fluent setter")
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"errors"
+	"strconv"
+)
+
+// errNoScoresInResult is returned by AsScoreMap when the result was decoded with NoScores set,
+// so there are no real scores to report.
+var errNoScoresInResult = errors.New("zscan: result was decoded with NoScores, no scores available")
+
+// MemberScore is a single decoded ZSCAN element. ScorePresent is false when the reply was
+// produced with NOSCORES, in which case Score is always 0.
+type MemberScore struct {
+	Member       string
+	Score        float64
+	ScorePresent bool
+}
+
+// ZScanResult is a typed decoding of a ZSCAN reply. Whether Members carries scores depends on
+// whether the ZScanOptions used for the call had NoScores set; use NewZScanResult to decode the
+// raw reply according to that setting rather than inspecting the flat []string directly.
+type ZScanResult struct {
+	Cursor   string
+	Members  []MemberScore
+	NoScores bool
+}
+
+// NewZScanResult decodes a raw ZSCAN reply (cursor plus flat elements) into a ZScanResult.
+// noScores must match the NoScores setting of the ZScanOptions used for the call: when true,
+// elements are read as members only and every MemberScore.ScorePresent is false; otherwise
+// elements are read as member/score pairs.
+func NewZScanResult(cursor string, elements []string, noScores bool) (ZScanResult, error) {
+	result := ZScanResult{Cursor: cursor, NoScores: noScores}
+
+	if noScores {
+		result.Members = make([]MemberScore, 0, len(elements))
+		for _, member := range elements {
+			result.Members = append(result.Members, MemberScore{Member: member})
+		}
+		return result, nil
+	}
+
+	result.Members = make([]MemberScore, 0, len(elements)/2)
+	for i := 0; i+1 < len(elements); i += 2 {
+		score, err := strconv.ParseFloat(elements[i+1], 64)
+		if err != nil {
+			return ZScanResult{}, err
+		}
+		result.Members = append(result.Members, MemberScore{
+			Member:       elements[i],
+			Score:        score,
+			ScorePresent: true,
+		})
+	}
+	return result, nil
+}
+
+// AsScoreMap returns the result as a map[string]float64. It returns errNoScoresInResult if the
+// result was decoded with NoScores, since there are no real scores to report in that case and
+// fabricating zeroes would be indistinguishable from real zero scores; use AsMembers instead.
+func (r ZScanResult) AsScoreMap() (map[string]float64, error) {
+	if r.NoScores {
+		return nil, errNoScoresInResult
+	}
+	scores := make(map[string]float64, len(r.Members))
+	for _, m := range r.Members {
+		scores[m.Member] = m.Score
+	}
+	return scores, nil
+}
+
+// AsMembers returns just the member names, discarding any scores. This is the natural shape to
+// use when the result was decoded with NoScores set.
+func (r ZScanResult) AsMembers() []string {
+	members := make([]string, len(r.Members))
+	for i, m := range r.Members {
+		members[i] = m.Member
+	}
+	return members
+}
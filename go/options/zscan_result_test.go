@@ -0,0 +1,78 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "testing"
+
+func TestNewZScanResult_WithScores(t *testing.T) {
+	result, err := NewZScanResult("17", []string{"a", "1.5", "b", "2"}, false)
+	if err != nil {
+		t.Fatalf("NewZScanResult() error = %v, want nil", err)
+	}
+	if result.Cursor != "17" || result.NoScores {
+		t.Fatalf("got Cursor=%q NoScores=%v, want Cursor=17 NoScores=false", result.Cursor, result.NoScores)
+	}
+	want := []MemberScore{
+		{Member: "a", Score: 1.5, ScorePresent: true},
+		{Member: "b", Score: 2, ScorePresent: true},
+	}
+	if len(result.Members) != len(want) || result.Members[0] != want[0] || result.Members[1] != want[1] {
+		t.Fatalf("got %v, want %v", result.Members, want)
+	}
+}
+
+func TestNewZScanResult_NoScores(t *testing.T) {
+	result, err := NewZScanResult("0", []string{"a", "b"}, true)
+	if err != nil {
+		t.Fatalf("NewZScanResult() error = %v, want nil", err)
+	}
+	if !result.NoScores {
+		t.Fatal("NoScores = false, want true")
+	}
+	for _, m := range result.Members {
+		if m.ScorePresent {
+			t.Fatalf("member %q ScorePresent = true, want false", m.Member)
+		}
+	}
+}
+
+func TestNewZScanResult_MalformedScore(t *testing.T) {
+	if _, err := NewZScanResult("0", []string{"a", "not-a-float"}, false); err == nil {
+		t.Fatal("NewZScanResult() error = nil, want error for unparsable score")
+	}
+}
+
+func TestZScanResult_AsScoreMap_RejectsNoScores(t *testing.T) {
+	result, err := NewZScanResult("0", []string{"a", "b"}, true)
+	if err != nil {
+		t.Fatalf("NewZScanResult() error = %v, want nil", err)
+	}
+	if _, err := result.AsScoreMap(); err == nil {
+		t.Fatal("AsScoreMap() error = nil, want error for a NoScores result")
+	}
+}
+
+func TestZScanResult_AsScoreMap_WithScores(t *testing.T) {
+	result, err := NewZScanResult("0", []string{"a", "1", "b", "2"}, false)
+	if err != nil {
+		t.Fatalf("NewZScanResult() error = %v, want nil", err)
+	}
+	scores, err := result.AsScoreMap()
+	if err != nil {
+		t.Fatalf("AsScoreMap() error = %v, want nil", err)
+	}
+	if scores["a"] != 1 || scores["b"] != 2 || len(scores) != 2 {
+		t.Fatalf("got %v, want map[a:1 b:2]", scores)
+	}
+}
+
+func TestZScanResult_AsMembers(t *testing.T) {
+	result, err := NewZScanResult("0", []string{"a", "b"}, true)
+	if err != nil {
+		t.Fatalf("NewZScanResult() error = %v, want nil", err)
+	}
+	members := result.AsMembers()
+	if len(members) != 2 || members[0] != "a" || members[1] != "b" {
+		t.Fatalf("got %v, want [a b]", members)
+	}
+}